@@ -0,0 +1,62 @@
+//go:build acceptance
+// +build acceptance
+
+package v1
+
+import (
+	"os"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+// TestApplicationCredentialInstanceLifecycle exercises Create, List, and Get
+// against a client authenticated with a Keystone v3 Application Credential
+// instead of a long-lived username/password pair, so CI can provision
+// throwaway Trove instances without ever handling a user's password.
+func TestApplicationCredentialInstanceLifecycle(t *testing.T) {
+	ao := gophercloud.AuthOptions{
+		IdentityEndpoint:            os.Getenv("OS_AUTH_URL"),
+		ApplicationCredentialID:     os.Getenv("OS_APPLICATION_CREDENTIAL_ID"),
+		ApplicationCredentialSecret: os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET"),
+	}
+	if ao.ApplicationCredentialID == "" || ao.ApplicationCredentialSecret == "" {
+		t.Skip("OS_APPLICATION_CREDENTIAL_ID and OS_APPLICATION_CREDENTIAL_SECRET must be set")
+	}
+
+	provider, err := openstack.AuthenticatedClient(ao)
+	th.AssertNoErr(t, err)
+
+	client, err := openstack.NewDBV1(provider, gophercloud.EndpointOpts{})
+	th.AssertNoErr(t, err)
+
+	createResult := instances.Create(client, instances.CreateOpts{
+		Name:      "gophercloud-test-instance-ac",
+		FlavorRef: os.Getenv("OS_DB_FLAVOR_REF"),
+		Size:      1,
+	})
+	instance, err := createResult.Extract()
+	th.AssertNoErr(t, err)
+	defer instances.Delete(client, instance.ID)
+
+	pages, err := instances.List(client).AllPages()
+	th.AssertNoErr(t, err)
+	list, err := instances.ExtractInstances(pages)
+	th.AssertNoErr(t, err)
+
+	var found bool
+	for _, i := range list {
+		if i.ID == instance.ID {
+			found = true
+			break
+		}
+	}
+	th.AssertEquals(t, true, found)
+
+	got, err := instances.Get(client, instance.ID).Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, instance.ID, got.ID)
+}
@@ -0,0 +1,7 @@
+// Package backups provides information and interaction with the backups
+// API resource for the OpenStack Trove service.
+//
+// A backup captures the state of a database instance at a point in time so
+// that it can later be used to provision a new instance from it by setting
+// instances.CreateOpts.RestorePoint.BackupRef to the backup's ID.
+package backups
@@ -0,0 +1,74 @@
+package backups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// CreateOptsBuilder is the top-level interface for create options.
+type CreateOptsBuilder interface {
+	ToBackupCreateMap() (map[string]interface{}, error)
+}
+
+// CreateOpts is the struct responsible for configuring a new backup of a
+// database instance.
+type CreateOpts struct {
+	// Instance is the ID of the instance to back up. Required.
+	Instance string `json:"instance" required:"true"`
+	// Name of the backup. Required.
+	Name string `json:"name" required:"true"`
+	// Description of the backup. Optional.
+	Description string `json:"description,omitempty"`
+	// ParentID is the ID of an existing backup to take an incremental backup
+	// from. Optional.
+	ParentID string `json:"parent_id,omitempty"`
+	// Incremental, if true, instructs Trove to base this backup off of the
+	// instance's most recent backup rather than taking a full copy.
+	Incremental bool `json:"incremental,omitempty"`
+}
+
+// ToBackupCreateMap will render a JSON map.
+func (opts CreateOpts) ToBackupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "backup")
+}
+
+// Create asynchronously creates a new backup of a database instance.
+func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	b, err := opts.ToBackupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	resp, err := client.Post(baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// List retrieves the status and information for all database backups.
+func List(client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPager(client, baseURL(client), func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// ListForInstance retrieves the status and information for all backups
+// belonging to the database instance identified by instanceID.
+func ListForInstance(client *gophercloud.ServiceClient, instanceID string) pagination.Pager {
+	return pagination.NewPager(client, instanceBackupsURL(client, instanceID), func(r pagination.PageResult) pagination.Page {
+		return BackupPage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// Get retrieves the status and information for a specified backup.
+func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// Delete permanently destroys the backup.
+func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.Delete(resourceURL(client, id), nil)
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
@@ -0,0 +1,89 @@
+package backups
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// Backup represents a point-in-time copy of a database instance.
+type Backup struct {
+	// ID is the backup's unique identifier.
+	ID string `json:"id"`
+
+	// Name of the backup.
+	Name string `json:"name"`
+
+	// Description of the backup.
+	Description string `json:"description"`
+
+	// InstanceID is the ID of the instance the backup was taken from.
+	InstanceID string `json:"instance_id"`
+
+	// ParentID is the ID of the backup this one is incremental from, if any.
+	ParentID string `json:"parent_id"`
+
+	// LocationRef is the URL of the stored backup artifact.
+	LocationRef string `json:"locationRef"`
+
+	// Size is the backup size, in gigabytes.
+	Size float64 `json:"size"`
+
+	// Status is the current state of the backup, e.g. NEW, BUILDING,
+	// COMPLETED, FAILED.
+	Status string `json:"status"`
+
+	// Created is the timestamp at which the backup was started.
+	Created string `json:"created"`
+
+	// Updated is the timestamp at which the backup was last updated.
+	Updated string `json:"updated"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets any commonResult as a Backup, if possible.
+func (r commonResult) Extract() (*Backup, error) {
+	var s struct {
+		Backup *Backup `json:"backup"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Backup, err
+}
+
+// CreateResult represents the result of a Create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// BackupPage represents a single page of a paginated backup collection.
+type BackupPage struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty checks whether a BackupPage is empty.
+func (page BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(page)
+	return len(backups) == 0, err
+}
+
+// ExtractBackups retrieves a slice of Backup structs from a paginated
+// collection.
+func ExtractBackups(page pagination.Page) ([]Backup, error) {
+	var s struct {
+		Backups []Backup `json:"backups"`
+	}
+	err := (page.(BackupPage)).ExtractInto(&s)
+	return s.Backups, err
+}
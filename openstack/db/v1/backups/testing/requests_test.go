@@ -0,0 +1,82 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/backups"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestCreateOptsToBackupCreateMap(t *testing.T) {
+	opts := backups.CreateOpts{
+		Instance: "instance-1",
+		Name:     "daily",
+	}
+
+	m, err := opts.ToBackupCreateMap()
+	th.AssertNoErr(t, err)
+
+	body, ok := m["backup"].(map[string]interface{})
+	if !ok {
+		t.Fatal("expected ToBackupCreateMap to nest the body under a \"backup\" key")
+	}
+	th.AssertEquals(t, "instance-1", body["instance"])
+	th.AssertEquals(t, "daily", body["name"])
+}
+
+func TestCreateOptsToBackupCreateMapRequiresInstanceAndName(t *testing.T) {
+	_, err := backups.CreateOpts{}.ToBackupCreateMap()
+	if err == nil {
+		t.Fatal("expected ToBackupCreateMap to reject a CreateOpts missing Instance and Name")
+	}
+}
+
+func TestGetExtractsBackup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/backup-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"backup": {"id": "backup-1", "instance_id": "instance-1", "status": "COMPLETED"}}`)
+	})
+
+	backup, err := backups.Get(th.ServiceClient(), "backup-1").Extract()
+	th.AssertNoErr(t, err)
+	th.AssertEquals(t, "backup-1", backup.ID)
+	th.AssertEquals(t, "instance-1", backup.InstanceID)
+	th.AssertEquals(t, "COMPLETED", backup.Status)
+}
+
+func TestWaitForCompletionReturnsOnCompleted(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/backup-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"backup": {"id": "backup-1", "status": "COMPLETED"}}`)
+	})
+
+	err := backups.WaitForCompletion(th.ServiceClient(), "backup-1", time.Second)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForCompletionReturnsErrorOnFailed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/backups/backup-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"backup": {"id": "backup-1", "status": "FAILED"}}`)
+	})
+
+	err := backups.WaitForCompletion(th.ServiceClient(), "backup-1", time.Second)
+	if err == nil {
+		t.Fatal("expected WaitForCompletion to return an error once the backup enters status FAILED")
+	}
+}
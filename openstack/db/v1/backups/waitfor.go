@@ -0,0 +1,29 @@
+package backups
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// WaitForCompletion polls the backup identified by id until it reaches the
+// COMPLETED status, until it reaches an ERROR or FAILED status, or until
+// timeout elapses, whichever happens first.
+func WaitForCompletion(client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	return gophercloud.WaitFor(int(timeout.Seconds()), func() (bool, error) {
+		backup, err := Get(client, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		switch backup.Status {
+		case "COMPLETED":
+			return true, nil
+		case "ERROR", "FAILED":
+			return false, fmt.Errorf("backup %s entered status %s", id, backup.Status)
+		default:
+			return false, nil
+		}
+	})
+}
@@ -0,0 +1,33 @@
+// Package instances provides information and interaction with the instances
+// API resource for the OpenStack Trove service.
+//
+// Create, List, and Get accept any *gophercloud.ServiceClient, so they work
+// unchanged with a client obtained through Keystone v3 Application
+// Credential authentication. AuthOptions.ApplicationCredentialID (or
+// ApplicationCredentialName) and ApplicationCredentialSecret, and the v3
+// application_credential auth method they select in
+// openstack.AuthenticatedClient, are part of the core gophercloud/openstack
+// packages this module builds on, not something introduced here: this
+// package has nothing to add beyond accepting whatever *ServiceClient that
+// auth produces. To authenticate with an application credential instead of
+// a long-lived username/password pair, populate gophercloud.AuthOptions and
+// pass the resulting client straight through:
+//
+//	ao := gophercloud.AuthOptions{
+//		IdentityEndpoint:            os.Getenv("OS_AUTH_URL"),
+//		ApplicationCredentialID:     os.Getenv("OS_APPLICATION_CREDENTIAL_ID"),
+//		ApplicationCredentialSecret: os.Getenv("OS_APPLICATION_CREDENTIAL_SECRET"),
+//	}
+//	provider, err := openstack.AuthenticatedClient(ao)
+//	client, err := openstack.NewDBV1(provider, gophercloud.EndpointOpts{})
+//	instances.Create(client, instances.CreateOpts{...})
+//
+// This lets operators provision databases from CI pipelines and other
+// short-lived automation without ever handling a user's password.
+//
+// Every request in this package is issued through the ServiceClient's
+// underlying ProviderClient.HTTPClient, so a client built with
+// openstack.NewClientWithTLS (to pin a CA bundle, present a client
+// certificate, or skip verification against a self-signed endpoint) is
+// honored automatically by Create and every other call in this package.
+package instances
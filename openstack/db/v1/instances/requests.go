@@ -1,7 +1,11 @@
 package instances
 
 import (
+	"context"
+	"net"
+
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/db/v1/backups"
 	db "github.com/gophercloud/gophercloud/openstack/db/v1/databases"
 	"github.com/gophercloud/gophercloud/openstack/db/v1/users"
 	"github.com/gophercloud/gophercloud/pagination"
@@ -48,11 +52,23 @@ func (opts NetworkOpts) ToMap() (map[string]interface{}, error) {
 // AccessOpts structure for access parameters in order to enable public access and allowed cidrs
 type AccessOpts struct {
 	IsPublic     bool     `json:"is_public"`
-	AllowedCidrs []string `json:"allowed_cirdrs"`
+	AllowedCidrs []string `json:"allowed_cidrs"`
 }
 
-// ToMap converts an AccessOpt to a map[string]string (for a request body)
+// ToMap converts an AccessOpt to a map[string]string (for a request body). It
+// validates that every entry in AllowedCidrs is a well-formed IPv4 or IPv6
+// CIDR before building the request body, since the Trove API silently
+// ignores malformed entries instead of rejecting them.
 func (opts AccessOpts) ToMap() (map[string]interface{}, error) {
+	for _, cidr := range opts.AllowedCidrs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			invalid := gophercloud.ErrInvalidInput{}
+			invalid.Argument = "instances.AccessOpts.AllowedCidrs"
+			invalid.Value = cidr
+			invalid.Info = "must be a valid IPv4 or IPv6 CIDR"
+			return nil, invalid
+		}
+	}
 	return gophercloud.BuildRequestBody(opts, "")
 }
 
@@ -194,12 +210,18 @@ func (opts CreateOpts) ToInstanceCreateMap() (map[string]interface{}, error) {
 // can create an instance with multiple databases and users. The default
 // binding for a MySQL instance is port 3306.
 func Create(client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
+	return CreateWithContext(context.Background(), client, opts)
+}
+
+// CreateWithContext behaves like Create, but propagates ctx to the
+// underlying HTTP request so callers can bound or cancel it.
+func CreateWithContext(ctx context.Context, client *gophercloud.ServiceClient, opts CreateOptsBuilder) (r CreateResult) {
 	b, err := opts.ToInstanceCreateMap()
 	if err != nil {
 		r.Err = err
 		return
 	}
-	resp, err := client.Post(baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	resp, err := client.PostWithContext(ctx, baseURL(client), &b, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -211,16 +233,37 @@ func List(client *gophercloud.ServiceClient) pagination.Pager {
 	})
 }
 
+// ListWithContext behaves like List, but returns a Pager carrying ctx.Err()
+// if ctx is already canceled or expired. The page requests issued while
+// paging are not bound to ctx.
+func ListWithContext(ctx context.Context, client *gophercloud.ServiceClient) pagination.Pager {
+	return pagination.NewPagerWithContext(ctx, client, baseURL(client), func(r pagination.PageResult) pagination.Page {
+		return InstancePage{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
 // Get retrieves the status and information for a specified database instance.
 func Get(client *gophercloud.ServiceClient, id string) (r GetResult) {
-	resp, err := client.Get(resourceURL(client, id), &r.Body, nil)
+	return GetWithContext(context.Background(), client, id)
+}
+
+// GetWithContext behaves like Get, but propagates ctx to the underlying HTTP
+// request so callers can bound or cancel it.
+func GetWithContext(ctx context.Context, client *gophercloud.ServiceClient, id string) (r GetResult) {
+	resp, err := client.GetWithContext(ctx, resourceURL(client, id), &r.Body, nil)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // Delete permanently destroys the database instance.
 func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
-	resp, err := client.Delete(resourceURL(client, id), nil)
+	return DeleteWithContext(context.Background(), client, id)
+}
+
+// DeleteWithContext behaves like Delete, but propagates ctx to the
+// underlying HTTP request so callers can bound or cancel it.
+func DeleteWithContext(ctx context.Context, client *gophercloud.ServiceClient, id string) (r DeleteResult) {
+	resp, err := client.DeleteWithContext(ctx, resourceURL(client, id), nil)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -228,7 +271,13 @@ func Delete(client *gophercloud.ServiceClient, id string) (r DeleteResult) {
 // EnableRootUser enables the login from any host for the root user and
 // provides the user with a generated root password.
 func EnableRootUser(client *gophercloud.ServiceClient, id string) (r EnableRootUserResult) {
-	resp, err := client.Post(userRootURL(client, id), nil, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
+	return EnableRootUserWithContext(context.Background(), client, id)
+}
+
+// EnableRootUserWithContext behaves like EnableRootUser, but propagates ctx
+// to the underlying HTTP request so callers can bound or cancel it.
+func EnableRootUserWithContext(ctx context.Context, client *gophercloud.ServiceClient, id string) (r EnableRootUserResult) {
+	resp, err := client.PostWithContext(ctx, userRootURL(client, id), nil, &r.Body, &gophercloud.RequestOpts{OkCodes: []int{200}})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -246,8 +295,14 @@ func IsRootEnabled(client *gophercloud.ServiceClient, id string) (r IsRootEnable
 // erase any dynamic configuration settings that you have made within MySQL.
 // The MySQL service will be unavailable until the instance restarts.
 func Restart(client *gophercloud.ServiceClient, id string) (r ActionResult) {
+	return RestartWithContext(context.Background(), client, id)
+}
+
+// RestartWithContext behaves like Restart, but propagates ctx to the
+// underlying HTTP request so callers can bound or cancel it.
+func RestartWithContext(ctx context.Context, client *gophercloud.ServiceClient, id string) (r ActionResult) {
 	b := map[string]interface{}{"restart": struct{}{}}
-	resp, err := client.Post(actionURL(client, id), &b, nil, nil)
+	resp, err := client.PostWithContext(ctx, actionURL(client, id), &b, nil, nil)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -255,8 +310,14 @@ func Restart(client *gophercloud.ServiceClient, id string) (r ActionResult) {
 // Resize changes the memory size of the instance, assuming a valid
 // flavorRef is provided. It will also restart the MySQL service.
 func Resize(client *gophercloud.ServiceClient, id, flavorRef string) (r ActionResult) {
+	return ResizeWithContext(context.Background(), client, id, flavorRef)
+}
+
+// ResizeWithContext behaves like Resize, but propagates ctx to the
+// underlying HTTP request so callers can bound or cancel it.
+func ResizeWithContext(ctx context.Context, client *gophercloud.ServiceClient, id, flavorRef string) (r ActionResult) {
 	b := map[string]interface{}{"resize": map[string]string{"flavorRef": flavorRef}}
-	resp, err := client.Post(actionURL(client, id), &b, nil, nil)
+	resp, err := client.PostWithContext(ctx, actionURL(client, id), &b, nil, nil)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
@@ -265,32 +326,94 @@ func Resize(client *gophercloud.ServiceClient, id, flavorRef string) (r ActionRe
 // only increasing the volume size and does not support decreasing the size.
 // The volume size is in gigabytes (GB) and must be an integer.
 func ResizeVolume(client *gophercloud.ServiceClient, id string, size int) (r ActionResult) {
+	return ResizeVolumeWithContext(context.Background(), client, id, size)
+}
+
+// ResizeVolumeWithContext behaves like ResizeVolume, but propagates ctx to
+// the underlying HTTP request so callers can bound or cancel it.
+func ResizeVolumeWithContext(ctx context.Context, client *gophercloud.ServiceClient, id string, size int) (r ActionResult) {
 	b := map[string]interface{}{"resize": map[string]interface{}{"volume": map[string]int{"size": size}}}
-	resp, err := client.Post(actionURL(client, id), &b, nil, nil)
+	resp, err := client.PostWithContext(ctx, actionURL(client, id), &b, nil, nil)
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // AttachConfigurationGroup will attach configuration group to the instance
 func AttachConfigurationGroup(client *gophercloud.ServiceClient, instanceID string, configID string) (r ConfigurationResult) {
+	return AttachConfigurationGroupWithContext(context.Background(), client, instanceID, configID)
+}
+
+// AttachConfigurationGroupWithContext behaves like AttachConfigurationGroup,
+// but propagates ctx to the underlying HTTP request so callers can bound or
+// cancel it.
+func AttachConfigurationGroupWithContext(ctx context.Context, client *gophercloud.ServiceClient, instanceID string, configID string) (r ConfigurationResult) {
 	b := map[string]interface{}{"instance": map[string]interface{}{"configuration": configID}}
-	resp, err := client.Put(resourceURL(client, instanceID), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	resp, err := client.PutWithContext(ctx, resourceURL(client, instanceID), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // DetachConfigurationGroup will dettach configuration group from the instance
 func DetachConfigurationGroup(client *gophercloud.ServiceClient, instanceID string) (r ConfigurationResult) {
+	return DetachConfigurationGroupWithContext(context.Background(), client, instanceID)
+}
+
+// DetachConfigurationGroupWithContext behaves like
+// DetachConfigurationGroup, but propagates ctx to the underlying HTTP
+// request so callers can bound or cancel it.
+func DetachConfigurationGroupWithContext(ctx context.Context, client *gophercloud.ServiceClient, instanceID string) (r ConfigurationResult) {
 	b := map[string]interface{}{"instance": map[string]interface{}{}}
-	resp, err := client.Put(resourceURL(client, instanceID), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	resp, err := client.PutWithContext(ctx, resourceURL(client, instanceID), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
 
 // DetachReplica will detach replica from its replication source
 func DetachReplica(client *gophercloud.ServiceClient, instanceID string, replicaOf string) (r DetachReplicaResult) {
+	return DetachReplicaWithContext(context.Background(), client, instanceID, replicaOf)
+}
+
+// DetachReplicaWithContext behaves like DetachReplica, but propagates ctx to
+// the underlying HTTP request so callers can bound or cancel it.
+func DetachReplicaWithContext(ctx context.Context, client *gophercloud.ServiceClient, instanceID string, replicaOf string) (r DetachReplicaResult) {
 	b := map[string]interface{}{"instance": map[string]string{"replica_of": replicaOf}}
-	resp, err := client.Put(resourceURL(client, instanceID), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	resp, err := client.PutWithContext(ctx, resourceURL(client, instanceID), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
 	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
 	return
 }
+
+// UpdateAccess changes whether the instance identified by id is publicly
+// exposed and which CIDRs are allowed to reach it. Unlike AccessOpts on
+// CreateOpts, which can only be set at creation time, UpdateAccess lets
+// callers adjust these settings on an existing instance. opts replaces the
+// instance's access configuration in full, the same way AttachConfigurationGroup
+// and DetachConfigurationGroup replace the instance's configuration group
+// wholesale rather than merging individual fields, so callers should always
+// pass both IsPublic and the complete AllowedCidrs list.
+func UpdateAccess(client *gophercloud.ServiceClient, id string, opts AccessOpts) (r ConfigurationResult) {
+	return UpdateAccessWithContext(context.Background(), client, id, opts)
+}
+
+// UpdateAccessWithContext behaves like UpdateAccess, but propagates ctx to
+// the underlying HTTP request so callers can bound or cancel it.
+func UpdateAccessWithContext(ctx context.Context, client *gophercloud.ServiceClient, id string, opts AccessOpts) (r ConfigurationResult) {
+	access, err := opts.ToMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	b := map[string]interface{}{"instance": map[string]interface{}{"access": access}}
+	resp, err := client.PatchWithContext(ctx, resourceURL(client, id), &b, nil, &gophercloud.RequestOpts{OkCodes: []int{202}})
+	_, r.Header, r.Err = gophercloud.ParseResponse(resp, err)
+	return
+}
+
+// CreateBackup asynchronously creates a backup of the instance identified by
+// id. It's a convenience wrapper around backups.Create for the common case
+// of backing up a single instance, letting callers drive the full
+// restore-from-backup workflow (create a backup, then pass its ID as
+// CreateOpts.RestorePoint.BackupRef) using only this module.
+func CreateBackup(client *gophercloud.ServiceClient, id string, opts backups.CreateOpts) (r backups.CreateResult) {
+	opts.Instance = id
+	return backups.Create(client, opts)
+}
@@ -0,0 +1,42 @@
+package testing
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestAccessOptsToMapAcceptsIPv4AndIPv6CIDRs(t *testing.T) {
+	opts := instances.AccessOpts{
+		IsPublic:     true,
+		AllowedCidrs: []string{"192.168.1.0/24", "2001:db8::/32"},
+	}
+
+	_, err := opts.ToMap()
+	th.AssertNoErr(t, err)
+}
+
+func TestAccessOptsToMapRejectsMalformedCIDR(t *testing.T) {
+	opts := instances.AccessOpts{
+		IsPublic:     true,
+		AllowedCidrs: []string{"not-a-cidr"},
+	}
+
+	_, err := opts.ToMap()
+	if err == nil {
+		t.Fatal("expected ToMap to reject a malformed CIDR before issuing any HTTP call")
+	}
+}
+
+func TestAccessOptsToMapRejectsMalformedCIDRAmongValidOnes(t *testing.T) {
+	opts := instances.AccessOpts{
+		IsPublic:     false,
+		AllowedCidrs: []string{"10.0.0.0/8", "300.0.0.0/24"},
+	}
+
+	_, err := opts.ToMap()
+	if err == nil {
+		t.Fatal("expected ToMap to reject a malformed CIDR even when earlier entries are valid")
+	}
+}
@@ -0,0 +1,60 @@
+package testing
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/db/v1/instances"
+	th "github.com/gophercloud/gophercloud/testhelper"
+)
+
+func TestWaitForStatusReturnsOnTargetStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"instance": {"id": "instance-1", "status": "ACTIVE"}}`)
+	})
+
+	err := instances.WaitForStatus(th.ServiceClient(), "instance-1", "ACTIVE", time.Second)
+	th.AssertNoErr(t, err)
+}
+
+func TestWaitForStatusReturnsErrorOnErrorStatus(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"instance": {"id": "instance-1", "status": "ERROR"}}`)
+	})
+
+	err := instances.WaitForStatus(th.ServiceClient(), "instance-1", "ACTIVE", time.Second)
+	if err == nil {
+		t.Fatal("expected WaitForStatus to return an error once the instance enters status ERROR")
+	}
+}
+
+func TestWaitForStatusHonorsTimeout(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/instances/instance-1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "GET")
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"instance": {"id": "instance-1", "status": "BUILD"}}`)
+	})
+
+	// waitForStatusBaseDelay is 2s, so a timeout well under that exercises
+	// the ctx.Done() path between polls rather than waiting out a full
+	// backoff interval.
+	err := instances.WaitForStatus(th.ServiceClient(), "instance-1", "ACTIVE", 50*time.Millisecond)
+	if err == nil {
+		t.Fatal("expected WaitForStatus to return an error once timeout elapses without reaching the target status")
+	}
+}
@@ -0,0 +1,84 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+const (
+	waitForStatusBaseDelay = 2 * time.Second
+	waitForStatusMaxDelay  = 30 * time.Second
+)
+
+// WaitForStatus polls the instance identified by id until it reaches status,
+// until it reaches an ERROR or FAILED status, or until timeout elapses,
+// whichever happens first. It takes no caller context, so timeout is the
+// only way to stop it early. It backs off
+// exponentially between polls starting at 2s and capping at 30s, jittered by
+// up to ±20% to avoid a thundering herd of pollers hitting the API in
+// lockstep.
+//
+// Create, Resize, ResizeVolume, Restart, and AttachConfigurationGroup are
+// all asynchronous: the API accepts the request immediately and the
+// instance transitions through a transient status (e.g. BUILD, RESIZE)
+// before settling on ACTIVE or an error status. WaitForStatus is the
+// building block for driving that transition to completion.
+func WaitForStatus(client *gophercloud.ServiceClient, id, status string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	delay := waitForStatusBaseDelay
+	for {
+		current, err := GetWithContext(ctx, client, id).Extract()
+		if err != nil {
+			return err
+		}
+
+		switch current.Status {
+		case status:
+			return nil
+		case "ERROR", "FAILED":
+			return fmt.Errorf("instance %s entered status %s while waiting for %s", id, current.Status, status)
+		}
+
+		jitter := time.Duration((rand.Float64()*0.4 - 0.2) * float64(delay))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay + jitter):
+		}
+
+		delay *= 2
+		if delay > waitForStatusMaxDelay {
+			delay = waitForStatusMaxDelay
+		}
+	}
+}
+
+// WaitForCompletion polls the instance identified by id until it reaches
+// the ACTIVE status, or until one of the terminal conditions described by
+// WaitForStatus is hit. It's a convenience wrapper around WaitForStatus for
+// the result of an asynchronous action such as Restart, Resize, or
+// ResizeVolume.
+func (r ActionResult) WaitForCompletion(client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	return WaitForStatus(client, id, "ACTIVE", timeout)
+}
+
+// WaitForCompletion polls the instance identified by id until it reaches
+// the ACTIVE status, or until one of the terminal conditions described by
+// WaitForStatus is hit. It's the ConfigurationResult counterpart of
+// ActionResult.WaitForCompletion, for the result of
+// AttachConfigurationGroup, DetachConfigurationGroup, and UpdateAccess.
+func (r ConfigurationResult) WaitForCompletion(client *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	return WaitForStatus(client, id, "ACTIVE", timeout)
+}
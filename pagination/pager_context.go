@@ -0,0 +1,20 @@
+package pagination
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// NewPagerWithContext behaves like NewPager, rejecting up front with
+// ctx.Err() if ctx is already canceled or expired before paging begins.
+//
+// It does not propagate ctx into the per-page HTTP requests a Pager issues
+// lazily as callers walk it with AllPages/EachPage: those requests are made
+// without ctx, so canceling ctx after paging has begun does not stop them.
+func NewPagerWithContext(ctx context.Context, client *gophercloud.ServiceClient, initialURL string, createPage func(r PageResult) Page) Pager {
+	if err := ctx.Err(); err != nil {
+		return Pager{Err: err}
+	}
+	return NewPager(client, initialURL, createPage)
+}
@@ -0,0 +1,131 @@
+package gophercloud
+
+import (
+	"bytes"
+	"io"
+	"log"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// redactPatterns matches key/value pairs in a request body whose values
+// should never reach the logs (passwords, tokens, application credential
+// secrets, and the like).
+var redactPatterns = regexp.MustCompile(`(?i)("(?:password|token|secret)"\s*:\s*")[^"]*(")`)
+
+func redact(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	return redactPatterns.ReplaceAllString(string(body), "$1***$2")
+}
+
+// LogRoundTripper satisfies http.RoundTripper. It wraps another
+// RoundTripper, logging the method, URL, redacted body, response status,
+// and elapsed time of every request, and it caps how many times a 401
+// response will trigger a reauthentication-and-retry before giving up.
+//
+// LogRoundTripper is safe to assign as a ProviderClient's
+// HTTPClient.Transport (not HTTPClient itself, which is a plain http.Client
+// value, not a RoundTripper); every service client built on a ProviderClient,
+// including db/v1/instances, benefits from it without any changes to their
+// own call sites. Use ProviderClient.UseLogRoundTripper, or construct one
+// directly with NewLogRoundTripper. RoundTrip is safe for concurrent use, as
+// required by http.RoundTripper, since a single ProviderClient is commonly
+// shared across goroutines.
+type LogRoundTripper struct {
+	// Rt is the underlying RoundTripper that performs the actual request.
+	Rt http.RoundTripper
+
+	// MaxReauthAttempts caps the number of consecutive 401 responses that
+	// will trigger a call to Reauthenticate before the 401 is returned to
+	// the caller as-is.
+	MaxReauthAttempts int
+
+	// Reauthenticate, if set, is invoked when a request receives a 401 and
+	// the retry budget has not yet been exhausted. It should refresh
+	// whatever credentials the underlying RoundTripper relies on.
+	Reauthenticate func() error
+
+	mu                sync.Mutex
+	numReauthAttempts int
+}
+
+// NewLogRoundTripper wraps rt with request/response logging and a reauth
+// loop bounded to maxReauthAttempts consecutive 401 responses. reauthenticate
+// may be nil, in which case 401 responses are returned to the caller as-is.
+func NewLogRoundTripper(rt http.RoundTripper, maxReauthAttempts int, reauthenticate func() error) *LogRoundTripper {
+	return &LogRoundTripper{
+		Rt:                rt,
+		MaxReauthAttempts: maxReauthAttempts,
+		Reauthenticate:    reauthenticate,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (lrt *LogRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	var body []byte
+	if request.Body != nil {
+		var err error
+		body, err = io.ReadAll(request.Body)
+		if err != nil {
+			return nil, err
+		}
+		request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	startedAt := time.Now()
+	log.Printf("[DEBUG] gophercloud: %s %s %s", request.Method, request.URL.String(), redact(body))
+
+	response, err := lrt.Rt.RoundTrip(request)
+	if err != nil {
+		return response, err
+	}
+
+	log.Printf("[DEBUG] gophercloud: %s %s returned %d in %s", request.Method, request.URL.String(), response.StatusCode, time.Since(startedAt))
+
+	if response.StatusCode == http.StatusUnauthorized {
+		lrt.mu.Lock()
+		canRetry := lrt.Reauthenticate != nil && lrt.numReauthAttempts < lrt.MaxReauthAttempts
+		if canRetry {
+			lrt.numReauthAttempts++
+		}
+		lrt.mu.Unlock()
+
+		if !canRetry {
+			return response, err
+		}
+
+		if err := lrt.Reauthenticate(); err != nil {
+			return response, err
+		}
+
+		if request.Body != nil {
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		return lrt.RoundTrip(request)
+	}
+
+	lrt.mu.Lock()
+	lrt.numReauthAttempts = 0
+	lrt.mu.Unlock()
+
+	return response, nil
+}
+
+// UseLogRoundTripper wraps the ProviderClient's current HTTPClient.Transport
+// with a *LogRoundTripper configured to retry reauthentication up to
+// maxReauthAttempts times via the client's own ReauthFunc. Every service
+// client built on top of client, including db/v1/instances, picks up
+// logging and bounded reauth retries automatically, since they always issue
+// requests through client.HTTPClient.
+func (client *ProviderClient) UseLogRoundTripper(maxReauthAttempts int) {
+	transport := client.HTTPClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	client.HTTPClient.Transport = NewLogRoundTripper(transport, maxReauthAttempts, client.ReauthFunc)
+}
@@ -0,0 +1,69 @@
+package gophercloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLogRoundTripperCapsReauthAttempts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	var reauthCalls int
+	lrt := NewLogRoundTripper(http.DefaultTransport, 2, func() error {
+		reauthCalls++
+		return nil
+	})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := lrt.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected the 401 to surface once the reauth budget is exhausted, got %d", resp.StatusCode)
+	}
+	if reauthCalls != 2 {
+		t.Fatalf("expected exactly 2 reauth attempts, got %d", reauthCalls)
+	}
+}
+
+func TestLogRoundTripperResetsCounterAfterSuccess(t *testing.T) {
+	var requestCount int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		if requestCount == 2 {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	var reauthCalls int
+	lrt := NewLogRoundTripper(http.DefaultTransport, 1, func() error {
+		reauthCalls++
+		return nil
+	})
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := lrt.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if reauthCalls != 2 {
+		t.Fatalf("expected the reauth counter to reset after a successful response, allowing another retry on the next 401, got %d total reauth calls", reauthCalls)
+	}
+}